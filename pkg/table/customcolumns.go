@@ -0,0 +1,90 @@
+package table
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rancher/norman/v2/pkg/types/convert"
+)
+
+const (
+	customColumnsPrefix     = "custom-columns="
+	customColumnsFilePrefix = "custom-columns-file="
+)
+
+// parseCustomColumnsFormat recognizes kubectl's `custom-columns=NAME:.path,...`
+// and `custom-columns-file=` formats and builds the equivalent HeaderFormat
+// and ValueFormat templates, resolving each path through the customColumn
+// template func registered in localFuncMap.
+func parseCustomColumnsFormat(format string) (headerFormat, valueFormat string, ok bool, err error) {
+	var spec string
+	switch {
+	case strings.HasPrefix(format, customColumnsPrefix):
+		spec = strings.TrimPrefix(format, customColumnsPrefix)
+	case strings.HasPrefix(format, customColumnsFilePrefix):
+		path := strings.TrimPrefix(format, customColumnsFilePrefix)
+		content, readErr := os.ReadFile(path)
+		if readErr != nil {
+			return "", "", false, readErr
+		}
+		spec = strings.TrimSpace(string(content))
+	default:
+		return "", "", false, nil
+	}
+
+	var headers []string
+	var values []string
+	for _, col := range strings.Split(spec, ",") {
+		name, path, found := strings.Cut(col, ":")
+		if !found {
+			return "", "", false, fmt.Errorf("invalid custom-columns entry %q, expected NAME:PATH", col)
+		}
+		headers = append(headers, name)
+		values = append(values, fmt.Sprintf("{{customColumn . %q}}", path))
+	}
+
+	return strings.Join(headers, "\t") + "\n", strings.Join(values, "\t") + "\n", true, nil
+}
+
+// CustomColumn resolves a dotted, kubectl-jsonpath-like field path (e.g.
+// ".metadata.name") against obj and returns "<none>" when the path doesn't
+// resolve, matching kubectl's custom-columns printer.
+func CustomColumn(obj interface{}, path string) (string, error) {
+	data, ok := obj.(map[string]interface{})
+	if !ok {
+		converted, err := convert.EncodeToMap(obj)
+		if err != nil {
+			return "<none>", nil
+		}
+		data = converted
+	}
+
+	value, ok := lookupColumnPath(data, path)
+	if !ok {
+		return "<none>", nil
+	}
+
+	return convert.ToString(value), nil
+}
+
+func lookupColumnPath(data map[string]interface{}, path string) (interface{}, bool) {
+	path = strings.TrimPrefix(path, ".")
+	if path == "" {
+		return nil, false
+	}
+
+	var current interface{} = data
+	for _, part := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = m[part]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
@@ -0,0 +1,41 @@
+package table
+
+import (
+	"os"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	jsonPathPrefix     = "jsonpath="
+	jsonPathFilePrefix = "jsonpath-file="
+)
+
+// parseJSONPathFormat recognizes the `jsonpath=<expr>` and
+// `jsonpath-file=<path>` formats, compiling the expression so it can be
+// evaluated against every object the same way `kubectl -o jsonpath` does.
+// It returns a nil *jsonpath.JSONPath, nil error when format isn't jsonpath.
+func parseJSONPathFormat(format string) (*jsonpath.JSONPath, error) {
+	var expr string
+	switch {
+	case strings.HasPrefix(format, jsonPathPrefix):
+		expr = strings.TrimPrefix(format, jsonPathPrefix)
+	case strings.HasPrefix(format, jsonPathFilePrefix):
+		path := strings.TrimPrefix(format, jsonPathFilePrefix)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		expr = strings.TrimSpace(string(content))
+	default:
+		return nil, nil
+	}
+
+	jp := jsonpath.New("table").AllowMissingKeys(true)
+	if err := jp.Parse(expr); err != nil {
+		return nil, err
+	}
+
+	return jp, nil
+}
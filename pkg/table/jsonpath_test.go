@@ -0,0 +1,30 @@
+package table
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseJSONPathFormatFileTrimsTrailingNewline(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "format.jsonpath")
+	if err := os.WriteFile(path, []byte("{.Name}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	jp, err := parseJSONPathFormat("jsonpath-file=" + path)
+	if err != nil {
+		t.Fatalf("parseJSONPathFormat: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := jp.Execute(&buf, map[string]interface{}{"Name": "web-1"}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+
+	if got := buf.String(); got != "web-1" {
+		t.Fatalf("jsonpath-file output = %q, want %q (trailing newline from the file should be trimmed)", got, "web-1")
+	}
+}
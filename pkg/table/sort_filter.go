@@ -0,0 +1,185 @@
+package table
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rancher/norman/v2/pkg/types/convert"
+)
+
+// bufferedWriter wraps a Writer and defers every Write until Close, so
+// --sort-by and --filter can operate on the full result set the way
+// `kubectl get --sort-by` / `docker ps --filter` do, instead of the default
+// streaming behavior.
+type bufferedWriter struct {
+	inner  Writer
+	sortBy string
+	filter string
+	objs   []interface{}
+}
+
+func newBufferedWriter(inner Writer, sortBy, filter string) Writer {
+	return &bufferedWriter{
+		inner:  inner,
+		sortBy: sortBy,
+		filter: filter,
+	}
+}
+
+func (b *bufferedWriter) Write(obj interface{}) {
+	b.objs = append(b.objs, obj)
+}
+
+func (b *bufferedWriter) AddFormatFunc(name string, f FormatFunc) {
+	b.inner.AddFormatFunc(name, f)
+}
+
+func (b *bufferedWriter) Err() error {
+	return b.Close()
+}
+
+func (b *bufferedWriter) Close() error {
+	objs := b.objs
+	if b.filter != "" {
+		objs = filterObjects(objs, b.filter)
+	}
+	if b.sortBy != "" {
+		sortObjects(objs, b.sortBy)
+	}
+
+	for _, obj := range objs {
+		b.inner.Write(obj)
+	}
+
+	return b.inner.Close()
+}
+
+type filterCondition struct {
+	path   string
+	value  string
+	negate bool
+}
+
+// parseFilterConditions splits a `key=value,key!=value` expression into its
+// individual, ANDed conditions.
+func parseFilterConditions(filter string) []filterCondition {
+	var conditions []filterCondition
+	for _, part := range strings.Split(filter, ",") {
+		if part == "" {
+			continue
+		}
+		if path, value, ok := strings.Cut(part, "!="); ok {
+			conditions = append(conditions, filterCondition{path: path, value: value, negate: true})
+			continue
+		}
+		if path, value, ok := strings.Cut(part, "="); ok {
+			conditions = append(conditions, filterCondition{path: path, value: value})
+		}
+	}
+	return conditions
+}
+
+func filterObjects(objs []interface{}, filter string) []interface{} {
+	conditions := parseFilterConditions(filter)
+
+	result := make([]interface{}, 0, len(objs))
+	for _, obj := range objs {
+		if matchesFilters(obj, conditions) {
+			result = append(result, obj)
+		}
+	}
+	return result
+}
+
+func matchesFilters(obj interface{}, conditions []filterCondition) bool {
+	data, err := convert.EncodeToMap(obj)
+	if err != nil {
+		return false
+	}
+
+	for _, cond := range conditions {
+		value, _ := lookupColumnPath(data, cond.path)
+		matches := convert.ToString(value) == cond.value
+		if matches == cond.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// sortObjects sorts objs in place by the value at sortBy, using natural
+// ordering for numbers and timestamps and falling back to a lexical string
+// comparison otherwise. Each object's sort key is resolved once up front
+// (decorate-sort-undecorate) rather than on every comparison, since
+// resolving a key does a full convert.EncodeToMap of the object.
+func sortObjects(objs []interface{}, sortBy string) {
+	type keyed struct {
+		obj interface{}
+		key interface{}
+	}
+
+	decorated := make([]keyed, len(objs))
+	for i, obj := range objs {
+		decorated[i] = keyed{obj: obj, key: sortValue(obj, sortBy)}
+	}
+
+	sort.SliceStable(decorated, func(i, j int) bool {
+		return lessSortValue(decorated[i].key, decorated[j].key)
+	})
+
+	for i, d := range decorated {
+		objs[i] = d.obj
+	}
+}
+
+func sortValue(obj interface{}, path string) interface{} {
+	data, err := convert.EncodeToMap(obj)
+	if err != nil {
+		return nil
+	}
+	value, _ := lookupColumnPath(data, path)
+	return value
+}
+
+func lessSortValue(a, b interface{}) bool {
+	if at, aok := asTime(a); aok {
+		if bt, bok := asTime(b); bok {
+			return at.Before(bt)
+		}
+	}
+	if an, aok := asFloat(a); aok {
+		if bn, bok := asFloat(b); bok {
+			return an < bn
+		}
+	}
+	return convert.ToString(a) < convert.ToString(b)
+}
+
+func asFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func asTime(v interface{}) (time.Time, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	return t, err == nil
+}
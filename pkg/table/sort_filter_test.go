@@ -0,0 +1,45 @@
+package table
+
+import "testing"
+
+func TestSortObjectsNumeric(t *testing.T) {
+	objs := []interface{}{
+		map[string]interface{}{"Name": "c", "Age": 30},
+		map[string]interface{}{"Name": "a", "Age": 10},
+		map[string]interface{}{"Name": "b", "Age": 20},
+	}
+
+	sortObjects(objs, "Age")
+
+	var got []string
+	for _, obj := range objs {
+		got = append(got, obj.(map[string]interface{})["Name"].(string))
+	}
+
+	want := []string{"a", "b", "c"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("sortObjects order = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestFilterObjects(t *testing.T) {
+	objs := []interface{}{
+		map[string]interface{}{"Name": "a", "State": "running"},
+		map[string]interface{}{"Name": "b", "State": "stopped"},
+	}
+
+	result := filterObjects(objs, "State=running")
+	if len(result) != 1 {
+		t.Fatalf("filterObjects returned %d objects, want 1", len(result))
+	}
+	if result[0].(map[string]interface{})["Name"] != "a" {
+		t.Fatalf("filterObjects kept wrong object: %v", result[0])
+	}
+
+	result = filterObjects(objs, "State!=running")
+	if len(result) != 1 || result[0].(map[string]interface{})["Name"] != "b" {
+		t.Fatalf("filterObjects with != returned %v", result)
+	}
+}
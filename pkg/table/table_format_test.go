@@ -0,0 +1,28 @@
+package table
+
+import "testing"
+
+func TestParseTableFormatUnescapesTabsAndNewlines(t *testing.T) {
+	header, value, ok := parseTableFormat(`table {{.Name}}\t{{.Status}}\t{{.Age}}`)
+	if !ok {
+		t.Fatal("expected table format to be recognized")
+	}
+
+	if want := "NAME\tSTATUS\tAGE\n"; header != want {
+		t.Fatalf("header = %q, want %q", header, want)
+	}
+	if want := "{{.Name}}\t{{.Status}}\t{{.Age}}\n"; value != want {
+		t.Fatalf("value = %q, want %q", value, want)
+	}
+}
+
+func TestParseTableFormatOneHeaderPerAction(t *testing.T) {
+	header, _, ok := parseTableFormat(`table {{first .Name .Status}}\t{{.Age}}`)
+	if !ok {
+		t.Fatal("expected table format to be recognized")
+	}
+
+	if want := "NAME\tAGE\n"; header != want {
+		t.Fatalf("header = %q, want %q (one header per action, matching one value column each)", header, want)
+	}
+}
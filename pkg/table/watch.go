@@ -0,0 +1,59 @@
+package table
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// clearScreen is the ANSI sequence to clear the terminal and move the
+// cursor home, used to repaint the table in place on every tick.
+const clearScreen = "\033[2J\033[H"
+
+type fileWriter interface {
+	Fd() uintptr
+}
+
+// Watch re-renders a table every interval by calling fetch and writing the
+// results through a fresh Writer built from values/config, the same way
+// `podman ps --watch` / `docker stats` repaint a live dashboard. When
+// config.Writer() is a TTY the terminal is cleared before each render;
+// otherwise Watch falls back to simply appending each render. Watch returns
+// when ctx is canceled or fetch returns an error.
+func Watch(ctx context.Context, values [][]string, config WriterConfig, interval time.Duration, fetch func() ([]interface{}, error)) error {
+	isTTY := false
+	if f, ok := config.Writer().(fileWriter); ok {
+		isTTY = term.IsTerminal(int(f.Fd()))
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		objs, err := fetch()
+		if err != nil {
+			return err
+		}
+
+		if isTTY {
+			if _, err := config.Writer().Write([]byte(clearScreen)); err != nil {
+				return err
+			}
+		}
+
+		w := NewWriter(values, config)
+		for _, obj := range objs {
+			w.Write(obj)
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package table
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -8,26 +9,29 @@ import (
 	"strings"
 	"text/tabwriter"
 	"text/template"
+	"text/template/parse"
 
 	"github.com/Masterminds/sprig"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/rancher/norman/v2/pkg/types/convert"
+	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/yaml"
 )
 
 var (
 	localFuncMap = map[string]interface{}{
-		"json":        FormatJSON,
-		"jsoncompact": FormatJSONCompact,
-		"yaml":        FormatYAML,
-		"first":       FormatFirst,
-		"dump":        FormatSpew,
-		"toJson":      ToJSON,
-		"boolToStar":  BoolToStar,
-		"array":       ToArray,
-		"arrayFirst":  ToArrayFirst,
-		"graph":       Graph,
-		"pointer":     Pointer,
+		"json":         FormatJSON,
+		"jsoncompact":  FormatJSONCompact,
+		"yaml":         FormatYAML,
+		"first":        FormatFirst,
+		"dump":         FormatSpew,
+		"toJson":       ToJSON,
+		"boolToStar":   BoolToStar,
+		"array":        ToArray,
+		"arrayFirst":   ToArrayFirst,
+		"graph":        Graph,
+		"pointer":      Pointer,
+		"customColumn": CustomColumn,
 	}
 )
 
@@ -46,6 +50,10 @@ type writer struct {
 	headerPrinted bool
 	Writer        io.Writer
 	funcMap       map[string]interface{}
+	jsonPath      *jsonpath.JSONPath
+	csvWriter     *csv.Writer
+	csvHeaders    []string
+	csvColumns    []*template.Template
 }
 
 type FormatFunc interface{}
@@ -56,6 +64,8 @@ type WriterConfig interface {
 	Format() string
 	Writer() io.Writer
 	IDColumn() string
+	SortBy() string
+	Filter() string
 }
 
 func idFormat(idColumn string, values [][]string) string {
@@ -112,14 +122,43 @@ func NewWriter(values [][]string, config WriterConfig) Writer {
 	case "yaml":
 		t.HeaderFormat = ""
 		t.ValueFormat = "yaml"
+	case "csv", "tsv":
+		t.HeaderFormat = ""
+		t.ValueFormat = "csv"
+		t.csvWriter = csv.NewWriter(config.Writer())
+		if customFormat == "tsv" {
+			t.csvWriter.Comma = '\t'
+		}
+		t.csvHeaders, t.csvColumns, t.err = compileCSVColumns(values, t.funcMap)
+		if config.Quiet() {
+			t.csvHeaders = nil
+		}
 	case "raw":
 	default:
-		if customFormat != "" {
+		if jp, err := parseJSONPathFormat(customFormat); err != nil {
+			t.err = err
+		} else if jp != nil {
+			t.jsonPath = jp
+			t.HeaderFormat = ""
+			t.ValueFormat = "jsonpath"
+		} else if header, value, ok, err := parseCustomColumnsFormat(customFormat); err != nil {
+			t.err = err
+		} else if ok {
+			t.HeaderFormat = header
+			t.ValueFormat = value
+		} else if header, value, ok := parseTableFormat(customFormat); ok {
+			t.HeaderFormat = header
+			t.ValueFormat = value
+		} else if customFormat != "" {
 			t.ValueFormat = customFormat + "\n"
 			t.HeaderFormat = ""
 		}
 	}
 
+	if sortBy, filter := config.SortBy(), config.Filter(); sortBy != "" || filter != "" {
+		return newBufferedWriter(t, sortBy, filter)
+	}
+
 	return t
 }
 
@@ -146,6 +185,11 @@ func (t *writer) Write(obj interface{}) {
 		return
 	}
 
+	if t.csvWriter != nil {
+		t.writeCSVRow(obj)
+		return
+	}
+
 	t.writeHeader()
 	if t.err != nil {
 		return
@@ -179,6 +223,17 @@ func (t *writer) Write(obj interface{}) {
 		}
 		t.Writer.Write([]byte("---\n"))
 		_, t.err = t.Writer.Write(append(converted, []byte("\n")...))
+	case "jsonpath":
+		data, err := convert.EncodeToMap(obj)
+		if err != nil {
+			t.err = err
+			return
+		}
+		t.err = t.jsonPath.Execute(t.Writer, data)
+		if t.err != nil {
+			return
+		}
+		_, t.err = t.Writer.Write([]byte("\n"))
 	default:
 		data, err := convert.EncodeToMap(obj)
 		if err == nil {
@@ -201,6 +256,17 @@ func (t *writer) Close() error {
 	defer func() {
 		t.closed = true
 	}()
+
+	if t.csvWriter != nil {
+		t.writeCSVHeader()
+		if t.err != nil {
+			return t.err
+		}
+		t.csvWriter.Flush()
+		t.err = t.csvWriter.Error()
+		return t.err
+	}
+
 	t.writeHeader()
 	if t.err != nil {
 		return t.err
@@ -211,6 +277,69 @@ func (t *writer) Close() error {
 	return nil
 }
 
+// writeCSVHeader writes the header record exactly once, honoring Quiet()
+// (which clears csvHeaders) the same way the tabwriter path honors
+// HeaderFormat == "".
+func (t *writer) writeCSVHeader() {
+	if t.headerPrinted || len(t.csvHeaders) == 0 {
+		return
+	}
+	t.headerPrinted = true
+	t.err = t.csvWriter.Write(t.csvHeaders)
+}
+
+// writeCSVRow evaluates each column's value template and writes the
+// resulting fields as a single CSV/TSV record, so values containing tabs,
+// commas, quotes or newlines survive downstream shell parsing.
+func (t *writer) writeCSVRow(obj interface{}) {
+	t.writeCSVHeader()
+	if t.err != nil {
+		return
+	}
+
+	data, err := convert.EncodeToMap(obj)
+	if err == nil {
+		data["Typed"] = obj
+	}
+
+	row := make([]string, len(t.csvColumns))
+	for i, tmpl := range t.csvColumns {
+		buf := &strings.Builder{}
+		if err == nil {
+			t.err = tmpl.Execute(buf, data)
+		} else {
+			t.err = tmpl.Execute(buf, obj)
+		}
+		if t.err != nil {
+			return
+		}
+		row[i] = buf.String()
+	}
+
+	t.err = t.csvWriter.Write(row)
+}
+
+// compileCSVColumns reuses the [][]string{name, template} schema already
+// passed to NewWriter so csv/tsv output has the same columns as the default
+// tabwriter rendering, just evaluated per-column instead of joined into one
+// template.
+func compileCSVColumns(values [][]string, funcMap map[string]interface{}) ([]string, []*template.Template, error) {
+	var headers []string
+	var columns []*template.Template
+	for _, vals := range values {
+		if len(vals) < 2 {
+			continue
+		}
+		tmpl, err := template.New("").Funcs(funcMap).Parse(SimpleColumnFormat(vals[1]))
+		if err != nil {
+			return nil, nil, err
+		}
+		headers = append(headers, vals[0])
+		columns = append(columns, tmpl)
+	}
+	return headers, columns, nil
+}
+
 func (t *writer) printTemplate(out io.Writer, templateContent string, obj interface{}) error {
 	tmpl, err := template.New("").Funcs(t.funcMap).Parse(templateContent)
 	if err != nil {
@@ -220,6 +349,83 @@ func (t *writer) printTemplate(out io.Writer, templateContent string, obj interf
 	return tmpl.Execute(out, obj)
 }
 
+// tableEscapeReplacer unescapes the literal `\t`/`\n` sequences a shell
+// leaves behind in a single-quoted --format argument (text/template does not
+// interpret escapes itself), the same way podman's "table" format does, so
+// the value row and the derived header row split on the same separator.
+var tableEscapeReplacer = strings.NewReplacer(`\t`, "\t", `\n`, "\n")
+
+// parseTableFormat recognizes the podman-style "table <template>" format. It
+// strips the "table " keyword and walks the parsed template looking for field
+// accessors (e.g. {{.Name}}) so a header row can be derived automatically,
+// the same way `podman ps --format "table {{.Name}}\t{{.Status}}"` does.
+func parseTableFormat(format string) (headerFormat, valueFormat string, ok bool) {
+	if format != "table" && !strings.HasPrefix(format, "table ") {
+		return "", "", false
+	}
+
+	valueFormat = strings.TrimPrefix(strings.TrimPrefix(format, "table"), " ")
+	valueFormat = tableEscapeReplacer.Replace(valueFormat) + "\n"
+
+	// Functions are only resolved when the value template is executed, so
+	// skip the function check here to accept {{first .Name .Status}} etc.
+	tree := parse.New("")
+	tree.Mode = parse.SkipFuncCheck
+	if _, err := tree.Parse(valueFormat, "", "", map[string]*parse.Tree{}); err != nil {
+		return "", "", false
+	}
+
+	var headers []string
+	collectFieldHeaders(tree.Root, &headers)
+	if len(headers) == 0 {
+		return "", "", false
+	}
+
+	return strings.Join(headers, "\t") + "\n", valueFormat, true
+}
+
+// collectFieldHeaders walks a template's top-level actions, collecting the
+// last identifier of the first field chain referenced by each one (so
+// {{.Container.Name}} yields "NAME" and {{first .Name .Status}} still
+// contributes exactly one header) so the header and value column counts
+// stay in sync. Only actions directly in list are considered; fields inside
+// range/if/with bodies aren't collected, matching the flat
+// "table {{.A}}\t{{.B}}" templates this format targets.
+func collectFieldHeaders(list *parse.ListNode, headers *[]string) {
+	if list == nil {
+		return
+	}
+
+	for _, node := range list.Nodes {
+		action, ok := node.(*parse.ActionNode)
+		if !ok {
+			continue
+		}
+
+		if field := firstFieldNode(action.Pipe); field != nil {
+			last := field.Ident[len(field.Ident)-1]
+			*headers = append(*headers, strings.ToUpper(last))
+		}
+	}
+}
+
+// firstFieldNode returns the first field accessor referenced anywhere in
+// pipe, or nil if it references none.
+func firstFieldNode(pipe *parse.PipeNode) *parse.FieldNode {
+	if pipe == nil {
+		return nil
+	}
+
+	for _, cmd := range pipe.Cmds {
+		for _, arg := range cmd.Args {
+			if field, ok := arg.(*parse.FieldNode); ok && len(field.Ident) > 0 {
+				return field
+			}
+		}
+	}
+	return nil
+}
+
 func ToArray(s []string) (string, error) {
 	return strings.Join(s, ", "), nil
 }